@@ -0,0 +1,93 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/csrf"
+)
+
+func newTestCSRFMiddleware() func(http.Handler) http.Handler {
+	return csrf.Protect([]byte("test-csrf-secret-needs-32-bytes!"), csrf.Secure(false))
+}
+
+func TestExemptAPIFromCSRFAllowsJSONAPIRequests(t *testing.T) {
+	var called bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := exemptAPIFromCSRF(newTestCSRFMiddleware(), next)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/bookings", strings.NewReader(`{"tickets":1}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if !called || rec.Code != http.StatusOK {
+		t.Fatalf("expected a JSON API POST to skip CSRF, got called=%v code=%d", called, rec.Code)
+	}
+}
+
+func TestExemptAPIFromCSRFAllowsAPIDelete(t *testing.T) {
+	var called bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := exemptAPIFromCSRF(newTestCSRFMiddleware(), next)
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/bookings/1", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if !called || rec.Code != http.StatusNoContent {
+		t.Fatalf("expected an API DELETE to skip CSRF, got called=%v code=%d", called, rec.Code)
+	}
+}
+
+// TestExemptAPIFromCSRFStillProtectsFormPostsUnderAPI guards against
+// the hole a path-only exemption would open: CreateBooking is
+// registered for both / and /api/v1/ and branches on Content-Type, so
+// a forged application/x-www-form-urlencoded POST to /api/v1/bookings
+// must still be CSRF-checked even though it targets an "API" path.
+func TestExemptAPIFromCSRFStillProtectsFormPostsUnderAPI(t *testing.T) {
+	var called bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := exemptAPIFromCSRF(newTestCSRFMiddleware(), next)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/bookings", strings.NewReader("tickets=1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if called {
+		t.Fatal("expected a form-encoded POST under /api/ to stay CSRF-checked, but it reached the handler")
+	}
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 without a CSRF token, got %d", rec.Code)
+	}
+}
+
+func TestExemptAPIFromCSRFProtectsHTMLRoutes(t *testing.T) {
+	var called bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := exemptAPIFromCSRF(newTestCSRFMiddleware(), next)
+
+	req := httptest.NewRequest(http.MethodPost, "/bookings", strings.NewReader("tickets=1"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if called || rec.Code != http.StatusForbidden {
+		t.Fatalf("expected the HTML booking route to stay CSRF-protected, got called=%v code=%d", called, rec.Code)
+	}
+}
@@ -0,0 +1,40 @@
+// Package model holds the domain types shared by the store and web
+// layers: conferences, bookings, attachments, and user accounts.
+package model
+
+import "time"
+
+// Conference describes an event with a fixed ticket allocation.
+type Conference struct {
+	Name             string
+	TotalTickets     int
+	RemainingTickets int
+}
+
+// Attachment describes a file uploaded alongside a booking, e.g. a
+// scanned ID or a pre-printed ticket.
+type Attachment struct {
+	OriginalName string
+	StoredPath   string
+}
+
+// Booking is a single reservation of tickets by a user.
+type Booking struct {
+	ID         int
+	UserID     int
+	FirstName  string
+	LastName   string
+	Email      string
+	Tickets    int
+	BookedAt   time.Time
+	Attachment Attachment
+}
+
+// User is an identified account that can create and cancel its own
+// bookings. Admins can see every booking, not just their own.
+type User struct {
+	ID           int
+	Email        string
+	PasswordHash string
+	IsAdmin      bool
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+)
+
+// InMemoryStore keeps bookings in a process-local slice. It backs the
+// test suite and is also handy for running the server without a
+// database during local development.
+type InMemoryStore struct {
+	mu        sync.Mutex
+	conf      model.Conference
+	bookings  []model.Booking
+	nextID    int
+	uploadDir string
+}
+
+// NewInMemoryStore creates a store seeded with the given conference.
+// Uploaded attachments are written under uploadDir.
+func NewInMemoryStore(conf model.Conference, uploadDir string) *InMemoryStore {
+	return &InMemoryStore{
+		conf:      conf,
+		nextID:    1,
+		uploadDir: uploadDir,
+	}
+}
+
+func (s *InMemoryStore) GetConference() (model.Conference, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conf, nil
+}
+
+func (s *InMemoryStore) ListBookings() ([]model.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]model.Booking, len(s.bookings))
+	copy(out, s.bookings)
+	return out, nil
+}
+
+func (s *InMemoryStore) CreateBooking(b model.Booking) (model.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if b.Tickets <= 0 || b.Tickets > s.conf.RemainingTickets {
+		return model.Booking{}, ErrNotEnoughTickets
+	}
+
+	b.ID = s.nextID
+	s.nextID++
+	s.conf.RemainingTickets -= b.Tickets
+	s.bookings = append(s.bookings, b)
+	return b, nil
+}
+
+func (s *InMemoryStore) CancelBooking(id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, b := range s.bookings {
+		if b.ID == id {
+			s.conf.RemainingTickets += b.Tickets
+			s.bookings = append(s.bookings[:i], s.bookings[i+1:]...)
+			return nil
+		}
+	}
+	return ErrBookingNotFound
+}
+
+func (s *InMemoryStore) AttachFile(id int, originalName string, data io.Reader) (model.Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	idx := -1
+	for i, b := range s.bookings {
+		if b.ID == id {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return model.Attachment{}, ErrBookingNotFound
+	}
+
+	if err := os.MkdirAll(s.uploadDir, 0o755); err != nil {
+		return model.Attachment{}, err
+	}
+
+	storedPath := filepath.Join(s.uploadDir, newAttachmentName(originalName))
+
+	f, err := os.Create(storedPath)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, data); err != nil {
+		return model.Attachment{}, err
+	}
+
+	att := model.Attachment{OriginalName: originalName, StoredPath: storedPath}
+	s.bookings[idx].Attachment = att
+	return att, nil
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+)
+
+func newTestStore(t *testing.T) *InMemoryStore {
+	t.Helper()
+	return NewInMemoryStore(model.Conference{Name: "Test Conf", TotalTickets: 2, RemainingTickets: 2}, t.TempDir())
+}
+
+func TestInMemoryStoreCreateBooking(t *testing.T) {
+	s := newTestStore(t)
+
+	b, err := s.CreateBooking(model.Booking{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", Tickets: 1})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+	if b.ID == 0 {
+		t.Fatalf("expected a non-zero ID, got %d", b.ID)
+	}
+
+	conf, err := s.GetConference()
+	if err != nil {
+		t.Fatalf("GetConference: %v", err)
+	}
+	if conf.RemainingTickets != 1 {
+		t.Fatalf("expected 1 remaining ticket, got %d", conf.RemainingTickets)
+	}
+}
+
+func TestInMemoryStoreCreateBookingNotEnoughTickets(t *testing.T) {
+	s := newTestStore(t)
+
+	if _, err := s.CreateBooking(model.Booking{FirstName: "Ada", Tickets: 3}); err != ErrNotEnoughTickets {
+		t.Fatalf("expected ErrNotEnoughTickets, got %v", err)
+	}
+}
+
+func TestInMemoryStoreCancelBooking(t *testing.T) {
+	s := newTestStore(t)
+
+	b, err := s.CreateBooking(model.Booking{FirstName: "Ada", Tickets: 2})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+
+	if err := s.CancelBooking(b.ID); err != nil {
+		t.Fatalf("CancelBooking: %v", err)
+	}
+
+	conf, _ := s.GetConference()
+	if conf.RemainingTickets != 2 {
+		t.Fatalf("expected tickets to be restored, got %d remaining", conf.RemainingTickets)
+	}
+
+	if err := s.CancelBooking(b.ID); err != ErrBookingNotFound {
+		t.Fatalf("expected ErrBookingNotFound on second cancel, got %v", err)
+	}
+}
+
+func TestInMemoryStoreAttachFile(t *testing.T) {
+	s := newTestStore(t)
+
+	b, err := s.CreateBooking(model.Booking{FirstName: "Ada", Tickets: 1})
+	if err != nil {
+		t.Fatalf("CreateBooking: %v", err)
+	}
+
+	att, err := s.AttachFile(b.ID, "ticket.pdf", strings.NewReader("pdf-bytes"))
+	if err != nil {
+		t.Fatalf("AttachFile: %v", err)
+	}
+	if att.OriginalName != "ticket.pdf" {
+		t.Fatalf("expected original name to be preserved, got %q", att.OriginalName)
+	}
+	if !strings.HasSuffix(att.StoredPath, ".pdf") {
+		t.Fatalf("expected stored path to keep the .pdf extension, got %q", att.StoredPath)
+	}
+
+	bookings, err := s.ListBookings()
+	if err != nil {
+		t.Fatalf("ListBookings: %v", err)
+	}
+	if bookings[0].Attachment.StoredPath == "" {
+		t.Fatalf("expected booking to record the attachment")
+	}
+}
+
+func TestInMemoryStoreAttachFileUnknownBooking(t *testing.T) {
+	s := newTestStore(t)
+
+	if _, err := s.AttachFile(999, "ticket.pdf", strings.NewReader("x")); err != ErrBookingNotFound {
+		t.Fatalf("expected ErrBookingNotFound, got %v", err)
+	}
+}
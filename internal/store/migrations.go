@@ -0,0 +1,54 @@
+package store
+
+import (
+	"database/sql"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+)
+
+// schemaMigrations are applied in order, each wrapped in its own
+// statement so a partially-initialized database can be re-run safely.
+var schemaMigrations = []string{
+	`CREATE TABLE IF NOT EXISTS conference (
+		id                INTEGER PRIMARY KEY DEFAULT 1,
+		name              TEXT NOT NULL,
+		total_tickets     INTEGER NOT NULL,
+		remaining_tickets INTEGER NOT NULL,
+		CONSTRAINT single_row CHECK (id = 1)
+	)`,
+	`CREATE TABLE IF NOT EXISTS bookings (
+		id                  SERIAL PRIMARY KEY,
+		user_id             INTEGER NOT NULL DEFAULT 0,
+		first_name          TEXT NOT NULL,
+		last_name           TEXT NOT NULL,
+		email               TEXT NOT NULL,
+		tickets             INTEGER NOT NULL,
+		booked_at           TIMESTAMPTZ NOT NULL DEFAULT now(),
+		attachment_name     TEXT,
+		attachment_path     TEXT
+	)`,
+	`CREATE TABLE IF NOT EXISTS users (
+		id              SERIAL PRIMARY KEY,
+		email           TEXT NOT NULL UNIQUE,
+		password_hash   TEXT NOT NULL,
+		is_admin        BOOLEAN NOT NULL DEFAULT false
+	)`,
+}
+
+// runMigrations applies schemaMigrations in order and seeds the
+// conference row with seed if one does not already exist.
+func runMigrations(db *sql.DB, seed model.Conference) error {
+	for _, stmt := range schemaMigrations {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	_, err := db.Exec(
+		`INSERT INTO conference (id, name, total_tickets, remaining_tickets)
+		 VALUES (1, $1, $2, $3)
+		 ON CONFLICT (id) DO NOTHING`,
+		seed.Name, seed.TotalTickets, seed.RemainingTickets,
+	)
+	return err
+}
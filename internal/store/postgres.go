@@ -0,0 +1,172 @@
+package store
+
+import (
+	"database/sql"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	_ "github.com/lib/pq"
+)
+
+// PostgresStore persists conference and booking state in Postgres.
+// Ticket counts are decremented inside the same transaction that
+// inserts the booking row, so concurrent bookings can never oversell.
+type PostgresStore struct {
+	db        *sql.DB
+	uploadDir string
+}
+
+// NewPostgresStore opens a connection to dsn, runs schemaMigrations,
+// seeding the conference row with seed if it doesn't exist yet, and
+// returns a ready-to-use store. Attachments are written under
+// uploadDir.
+func NewPostgresStore(dsn string, seed model.Conference, uploadDir string) (*PostgresStore, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		return nil, err
+	}
+	if err := runMigrations(db, seed); err != nil {
+		return nil, err
+	}
+	return &PostgresStore{db: db, uploadDir: uploadDir}, nil
+}
+
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+// DB returns the underlying connection, so a PostgresUserStore can
+// share it instead of opening a second pool against the same database.
+func (s *PostgresStore) DB() *sql.DB {
+	return s.db
+}
+
+func (s *PostgresStore) GetConference() (model.Conference, error) {
+	var c model.Conference
+	row := s.db.QueryRow(`SELECT name, total_tickets, remaining_tickets FROM conference WHERE id = 1`)
+	if err := row.Scan(&c.Name, &c.TotalTickets, &c.RemainingTickets); err != nil {
+		return model.Conference{}, err
+	}
+	return c, nil
+}
+
+func (s *PostgresStore) ListBookings() ([]model.Booking, error) {
+	rows, err := s.db.Query(`
+		SELECT id, user_id, first_name, last_name, email, tickets, booked_at,
+		       COALESCE(attachment_name, ''), COALESCE(attachment_path, '')
+		FROM bookings ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []model.Booking
+	for rows.Next() {
+		var b model.Booking
+		if err := rows.Scan(&b.ID, &b.UserID, &b.FirstName, &b.LastName, &b.Email, &b.Tickets, &b.BookedAt,
+			&b.Attachment.OriginalName, &b.Attachment.StoredPath); err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+// CreateBooking inserts b and decrements remaining_tickets atomically,
+// rolling back if not enough tickets remain.
+func (s *PostgresStore) CreateBooking(b model.Booking) (model.Booking, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return model.Booking{}, err
+	}
+	defer tx.Rollback()
+
+	var remaining int
+	if err := tx.QueryRow(`SELECT remaining_tickets FROM conference WHERE id = 1 FOR UPDATE`).Scan(&remaining); err != nil {
+		return model.Booking{}, err
+	}
+	if b.Tickets <= 0 || b.Tickets > remaining {
+		return model.Booking{}, ErrNotEnoughTickets
+	}
+
+	if _, err := tx.Exec(`UPDATE conference SET remaining_tickets = remaining_tickets - $1 WHERE id = 1`, b.Tickets); err != nil {
+		return model.Booking{}, err
+	}
+
+	row := tx.QueryRow(`
+		INSERT INTO bookings (user_id, first_name, last_name, email, tickets, booked_at)
+		VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		b.UserID, b.FirstName, b.LastName, b.Email, b.Tickets, b.BookedAt)
+	if err := row.Scan(&b.ID); err != nil {
+		return model.Booking{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return model.Booking{}, err
+	}
+	return b, nil
+}
+
+// CancelBooking removes the booking and restores its tickets to the
+// conference total in the same transaction.
+func (s *PostgresStore) CancelBooking(id int) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var tickets int
+	err = tx.QueryRow(`DELETE FROM bookings WHERE id = $1 RETURNING tickets`, id).Scan(&tickets)
+	if err == sql.ErrNoRows {
+		return ErrBookingNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`UPDATE conference SET remaining_tickets = remaining_tickets + $1 WHERE id = 1`, tickets); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *PostgresStore) AttachFile(id int, originalName string, data io.Reader) (model.Attachment, error) {
+	var exists bool
+	if err := s.db.QueryRow(`SELECT EXISTS (SELECT 1 FROM bookings WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return model.Attachment{}, err
+	}
+	if !exists {
+		return model.Attachment{}, ErrBookingNotFound
+	}
+
+	if err := os.MkdirAll(s.uploadDir, 0o755); err != nil {
+		return model.Attachment{}, err
+	}
+
+	storedName := newAttachmentName(originalName)
+	storedPath := filepath.Join(s.uploadDir, storedName)
+
+	f, err := os.Create(storedPath)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, data); err != nil {
+		return model.Attachment{}, err
+	}
+
+	_, err = s.db.Exec(`UPDATE bookings SET attachment_name = $1, attachment_path = $2 WHERE id = $3`,
+		originalName, storedPath, id)
+	if err != nil {
+		return model.Attachment{}, err
+	}
+
+	return model.Attachment{OriginalName: originalName, StoredPath: storedPath}, nil
+}
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"database/sql"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/lib/pq"
+)
+
+// PostgresUserStore persists registered accounts in the users table,
+// so they survive restarts alongside the bookings PostgresStore keeps
+// in the same database.
+type PostgresUserStore struct {
+	db *sql.DB
+}
+
+// NewPostgresUserStore returns a user store backed by db. Callers
+// running PostgresStore should pass its DB() so both stores share one
+// connection pool; the users table migration runs as part of
+// NewPostgresStore's runMigrations.
+func NewPostgresUserStore(db *sql.DB) *PostgresUserStore {
+	return &PostgresUserStore{db: db}
+}
+
+func (s *PostgresUserStore) CreateUser(email, passwordHash string, isAdmin bool) (model.User, error) {
+	var u model.User
+	row := s.db.QueryRow(
+		`INSERT INTO users (email, password_hash, is_admin) VALUES ($1, $2, $3) RETURNING id, email, password_hash, is_admin`,
+		email, passwordHash, isAdmin,
+	)
+	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.IsAdmin); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return model.User{}, ErrUserExists
+		}
+		return model.User{}, err
+	}
+	return u, nil
+}
+
+func (s *PostgresUserStore) GetUserByEmail(email string) (model.User, error) {
+	var u model.User
+	row := s.db.QueryRow(`SELECT id, email, password_hash, is_admin FROM users WHERE email = $1`, email)
+	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.IsAdmin); err != nil {
+		if err == sql.ErrNoRows {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+	return u, nil
+}
+
+func (s *PostgresUserStore) GetUserByID(id int) (model.User, error) {
+	var u model.User
+	row := s.db.QueryRow(`SELECT id, email, password_hash, is_admin FROM users WHERE id = $1`, id)
+	if err := row.Scan(&u.ID, &u.Email, &u.PasswordHash, &u.IsAdmin); err != nil {
+		if err == sql.ErrNoRows {
+			return model.User{}, ErrUserNotFound
+		}
+		return model.User{}, err
+	}
+	return u, nil
+}
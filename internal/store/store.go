@@ -0,0 +1,38 @@
+// Package store defines the persistence boundary for the ticketing
+// system and provides an in-memory implementation (tests, local dev)
+// and a PostgreSQL-backed one (production).
+package store
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/google/uuid"
+)
+
+// ErrBookingNotFound is returned when a booking ID does not exist.
+var ErrBookingNotFound = errors.New("booking not found")
+
+// ErrNotEnoughTickets is returned when a booking requests more tickets
+// than are currently available.
+var ErrNotEnoughTickets = errors.New("not enough tickets remaining")
+
+// Store is the persistence boundary for conferences and bookings. It
+// is implemented by InMemoryStore (used in tests and for local dev)
+// and PostgresStore (used in production).
+type Store interface {
+	GetConference() (model.Conference, error)
+	ListBookings() ([]model.Booking, error)
+	CreateBooking(b model.Booking) (model.Booking, error)
+	CancelBooking(id int) error
+	AttachFile(id int, originalName string, data io.Reader) (model.Attachment, error)
+}
+
+// newAttachmentName generates a collision-free filename for a stored
+// upload, keeping the original extension so the file can still be
+// served with a sensible content type.
+func newAttachmentName(originalName string) string {
+	return uuid.NewString() + filepath.Ext(originalName)
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+)
+
+// ErrUserExists is returned by CreateUser when the email is already
+// registered.
+var ErrUserExists = errors.New("user already exists")
+
+// ErrUserNotFound is returned when no user matches the given email/ID.
+var ErrUserNotFound = errors.New("user not found")
+
+// UserStore manages registered accounts, separately from Store so auth
+// can evolve (e.g. OAuth) without touching booking persistence. It is
+// implemented by InMemoryUserStore and PostgresUserStore.
+type UserStore interface {
+	CreateUser(email, passwordHash string, isAdmin bool) (model.User, error)
+	GetUserByEmail(email string) (model.User, error)
+	GetUserByID(id int) (model.User, error)
+}
+
+// InMemoryUserStore keeps registered accounts in a process-local map.
+// Like InMemoryStore, it is good enough for tests and local dev.
+type InMemoryUserStore struct {
+	mu     sync.Mutex
+	byID   map[int]model.User
+	nextID int
+}
+
+// NewInMemoryUserStore returns an empty user store.
+func NewInMemoryUserStore() *InMemoryUserStore {
+	return &InMemoryUserStore{byID: make(map[int]model.User), nextID: 1}
+}
+
+func (s *InMemoryUserStore) CreateUser(email, passwordHash string, isAdmin bool) (model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.byID {
+		if u.Email == email {
+			return model.User{}, ErrUserExists
+		}
+	}
+
+	u := model.User{ID: s.nextID, Email: email, PasswordHash: passwordHash, IsAdmin: isAdmin}
+	s.byID[u.ID] = u
+	s.nextID++
+	return u, nil
+}
+
+func (s *InMemoryUserStore) GetUserByEmail(email string) (model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.byID {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return model.User{}, ErrUserNotFound
+}
+
+func (s *InMemoryUserStore) GetUserByID(id int) (model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.byID[id]
+	if !ok {
+		return model.User{}, ErrUserNotFound
+	}
+	return u, nil
+}
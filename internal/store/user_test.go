@@ -0,0 +1,62 @@
+package store
+
+import "testing"
+
+func TestInMemoryUserStoreCreateAndFetch(t *testing.T) {
+	s := NewInMemoryUserStore()
+
+	u, err := s.CreateUser("ada@example.com", "hashed", false)
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+
+	byEmail, err := s.GetUserByEmail("ada@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail: %v", err)
+	}
+	if byEmail.ID != u.ID {
+		t.Fatalf("expected ID %d, got %d", u.ID, byEmail.ID)
+	}
+
+	byID, err := s.GetUserByID(u.ID)
+	if err != nil {
+		t.Fatalf("GetUserByID: %v", err)
+	}
+	if byID.Email != "ada@example.com" {
+		t.Fatalf("expected email to round-trip, got %q", byID.Email)
+	}
+}
+
+func TestInMemoryUserStoreDuplicateEmail(t *testing.T) {
+	s := NewInMemoryUserStore()
+
+	if _, err := s.CreateUser("ada@example.com", "hashed", false); err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if _, err := s.CreateUser("ada@example.com", "other", false); err != ErrUserExists {
+		t.Fatalf("expected ErrUserExists, got %v", err)
+	}
+}
+
+func TestInMemoryUserStoreCreateAdmin(t *testing.T) {
+	s := NewInMemoryUserStore()
+
+	u, err := s.CreateUser("admin@example.com", "hashed", true)
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if !u.IsAdmin {
+		t.Fatal("expected the created user to be an admin")
+	}
+}
+
+func TestInMemoryUserStoreUnknown(t *testing.T) {
+	s := NewInMemoryUserStore()
+
+	if _, err := s.GetUserByEmail("missing@example.com"); err != ErrUserNotFound {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if _, err := s.GetUserByID(42); err != ErrUserNotFound {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+}
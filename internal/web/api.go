@@ -0,0 +1,29 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// APIConference returns the conference's ticket totals as JSON.
+func (h *Handler) APIConference(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	conf, err := h.Store.GetConference()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load conference")
+		return
+	}
+	writeJSON(w, http.StatusOK, conf)
+}
+
+// APIListBookings returns the bookings visible to the current user as
+// JSON: every booking for admins, only their own otherwise.
+func (h *Handler) APIListBookings(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	all, err := h.Store.ListBookings()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load bookings")
+		return
+	}
+	user, loggedIn := userFromContext(r.Context())
+	writeJSON(w, http.StatusOK, visibleBookings(all, user, loggedIn))
+}
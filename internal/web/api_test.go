@@ -0,0 +1,50 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestAPIConferenceReturnsTotals(t *testing.T) {
+	h := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/conference", nil)
+	rec := httptest.NewRecorder()
+	h.APIConference(rec, req, httprouter.Params{})
+
+	var conf model.Conference
+	if err := json.NewDecoder(rec.Body).Decode(&conf); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if conf.TotalTickets != 10 {
+		t.Fatalf("expected the seeded conference, got %+v", conf)
+	}
+}
+
+func TestAPIListBookingsScopesToCaller(t *testing.T) {
+	h := newTestHandler(t)
+	if _, err := h.Store.CreateBooking(model.Booking{UserID: 1, FirstName: "Ada", Tickets: 1}); err != nil {
+		t.Fatalf("seed booking: %v", err)
+	}
+	if _, err := h.Store.CreateBooking(model.Booking{UserID: 2, FirstName: "Grace", Tickets: 1}); err != nil {
+		t.Fatalf("seed booking: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/bookings", nil)
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 1}))
+	rec := httptest.NewRecorder()
+	h.APIListBookings(rec, req, httprouter.Params{})
+
+	var bookings []model.Booking
+	if err := json.NewDecoder(rec.Body).Decode(&bookings); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if len(bookings) != 1 || bookings[0].UserID != 1 {
+		t.Fatalf("expected only the caller's booking, got %+v", bookings)
+	}
+}
@@ -0,0 +1,95 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/store"
+	"github.com/julienschmidt/httprouter"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Register handles account creation: GET shows the form, POST creates
+// the user and sends them to log in.
+func (h *Handler) Register(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if r.Method != http.MethodPost {
+		tpls, err := h.templates()
+		if err != nil {
+			writeError(w, r, http.StatusInternalServerError, "Could not load templates")
+			return
+		}
+		render(w, r, tpls.Register, nil)
+		return
+	}
+
+	email := r.FormValue("email")
+	password := r.FormValue("password")
+	if email == "" || password == "" {
+		writeError(w, r, http.StatusBadRequest, "Email and password are required")
+		return
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not register")
+		return
+	}
+
+	if _, err := h.Users.CreateUser(email, string(hash), h.isAdminEmail(email)); err != nil {
+		if err == store.ErrUserExists {
+			writeError(w, r, http.StatusConflict, "An account with that email already exists")
+			return
+		}
+		writeError(w, r, http.StatusInternalServerError, "Could not register")
+		return
+	}
+
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+// isAdminEmail reports whether email is in h.AdminEmails, the only way
+// an account becomes an admin.
+func (h *Handler) isAdminEmail(email string) bool {
+	_, ok := h.AdminEmails[strings.ToLower(email)]
+	return ok
+}
+
+// Login handles authentication: GET shows the form, POST verifies the
+// password and starts a session.
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	if r.Method != http.MethodPost {
+		tpls, err := h.templates()
+		if err != nil {
+			writeError(w, r, http.StatusInternalServerError, "Could not load templates")
+			return
+		}
+		render(w, r, tpls.Login, nil)
+		return
+	}
+
+	email := r.FormValue("email")
+	password := r.FormValue("password")
+
+	u, err := h.Users.GetUserByEmail(email)
+	if err != nil || bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) != nil {
+		writeError(w, r, http.StatusUnauthorized, "Invalid email or password")
+		return
+	}
+
+	session, _ := h.Sessions.Get(r, sessionName)
+	session.Values["userID"] = u.ID
+	if err := session.Save(r, w); err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not start session")
+		return
+	}
+
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// Logout clears the session cookie.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	session, _ := h.Sessions.Get(r, sessionName)
+	session.Options.MaxAge = -1
+	session.Save(r, w)
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
@@ -0,0 +1,121 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// postForm drives a form-encoded POST straight through handle, the
+// same way the router would but without the CSRF/session middleware
+// in front of it.
+func postForm(t *testing.T, handle httprouter.Handle, path string, values url.Values, cookie *http.Cookie) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	rec := httptest.NewRecorder()
+	handle(rec, req, httprouter.Params{})
+	return rec
+}
+
+func TestRegisterCreatesAccount(t *testing.T) {
+	h := newTestHandler(t)
+	values := url.Values{"email": {"ada@example.com"}, "password": {"password123"}}
+
+	rec := postForm(t, h.Register, "/register", values, nil)
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("expected a redirect to /login, got %d: %s", rec.Code, rec.Body)
+	}
+	if _, err := h.Users.GetUserByEmail("ada@example.com"); err != nil {
+		t.Fatalf("expected the account to exist: %v", err)
+	}
+}
+
+func TestRegisterDuplicateEmailConflicts(t *testing.T) {
+	h := newTestHandler(t)
+	values := url.Values{"email": {"ada@example.com"}, "password": {"password123"}}
+	postForm(t, h.Register, "/register", values, nil)
+
+	rec := postForm(t, h.Register, "/register", values, nil)
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409 for a duplicate email, got %d", rec.Code)
+	}
+}
+
+func TestRegisterPromotesAdminEmails(t *testing.T) {
+	h := newTestHandler(t)
+	h.AdminEmails = map[string]struct{}{"admin@example.com": {}}
+
+	postForm(t, h.Register, "/register", url.Values{"email": {"Admin@example.com"}, "password": {"password123"}}, nil)
+
+	u, err := h.Users.GetUserByEmail("Admin@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail: %v", err)
+	}
+	if !u.IsAdmin {
+		t.Fatal("expected an AdminEmails match to be promoted to admin, case-insensitively")
+	}
+}
+
+func TestRegisterDoesNotPromoteUnlistedEmails(t *testing.T) {
+	h := newTestHandler(t)
+	h.AdminEmails = map[string]struct{}{"admin@example.com": {}}
+
+	postForm(t, h.Register, "/register", url.Values{"email": {"ada@example.com"}, "password": {"password123"}}, nil)
+
+	u, err := h.Users.GetUserByEmail("ada@example.com")
+	if err != nil {
+		t.Fatalf("GetUserByEmail: %v", err)
+	}
+	if u.IsAdmin {
+		t.Fatal("expected an email not in AdminEmails to stay non-admin")
+	}
+}
+
+func TestLoginStartsSession(t *testing.T) {
+	h := newTestHandler(t)
+	values := url.Values{"email": {"ada@example.com"}, "password": {"password123"}}
+	postForm(t, h.Register, "/register", values, nil)
+
+	rec := postForm(t, h.Login, "/login", values, nil)
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("expected a redirect, got %d: %s", rec.Code, rec.Body)
+	}
+	if len(rec.Result().Cookies()) == 0 {
+		t.Fatal("expected login to set a session cookie")
+	}
+}
+
+func TestLoginRejectsWrongPassword(t *testing.T) {
+	h := newTestHandler(t)
+	postForm(t, h.Register, "/register", url.Values{"email": {"ada@example.com"}, "password": {"password123"}}, nil)
+
+	rec := postForm(t, h.Login, "/login", url.Values{"email": {"ada@example.com"}, "password": {"wrong"}}, nil)
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for a wrong password, got %d", rec.Code)
+	}
+}
+
+func TestLogoutClearsSession(t *testing.T) {
+	h := newTestHandler(t)
+	values := url.Values{"email": {"ada@example.com"}, "password": {"password123"}}
+	postForm(t, h.Register, "/register", values, nil)
+	loginRec := postForm(t, h.Login, "/login", values, nil)
+	cookie := loginRec.Result().Cookies()[0]
+
+	rec := postForm(t, h.Logout, "/logout", url.Values{}, cookie)
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("expected a redirect to /login, got %d", rec.Code)
+	}
+	logoutCookie := rec.Result().Cookies()[0]
+	if logoutCookie.MaxAge >= 0 {
+		t.Fatalf("expected logout to expire the session cookie, got MaxAge=%d", logoutCookie.MaxAge)
+	}
+}
@@ -0,0 +1,143 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/brianmwenda/GO-Ticketing_system/internal/store"
+	"github.com/julienschmidt/httprouter"
+)
+
+// renderRowHTML renders b through the bookings-row partial, for
+// splicing into the live table over SSE. It returns "" (logging the
+// cause) rather than failing the request the render was a side effect of.
+func (h *Handler) renderRowHTML(b model.Booking) string {
+	tpls, err := h.templates()
+	if err != nil {
+		h.Logger.Printf("load templates to render booking row %d: %v", b.ID, err)
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := tpls.Row.Execute(&buf, b); err != nil {
+		h.Logger.Printf("render booking row %d: %v", b.ID, err)
+		return ""
+	}
+	return buf.String()
+}
+
+// bookingInput is the set of fields a caller supplies to create a
+// booking, read from either a JSON body (API clients) or a form
+// (browser UI) depending on the request's Content-Type.
+type bookingInput struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Tickets   int    `json:"tickets"`
+}
+
+func parseBookingInput(r *http.Request) (bookingInput, error) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var in bookingInput
+		err := json.NewDecoder(r.Body).Decode(&in)
+		return in, err
+	}
+	tickets, _ := strconv.Atoi(r.FormValue("tickets"))
+	return bookingInput{
+		FirstName: r.FormValue("first"),
+		LastName:  r.FormValue("last"),
+		Email:     r.FormValue("email"),
+		Tickets:   tickets,
+	}, nil
+}
+
+// CreateBooking books tickets for the current user, and attaches any
+// uploaded file to the resulting booking. It serves both the HTML
+// form post and the JSON API, reading whichever input format the
+// request sent and responding in kind.
+func (h *Handler) CreateBooking(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	user, _ := userFromContext(r.Context())
+	in, err := parseBookingInput(r)
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "Invalid booking payload")
+		return
+	}
+
+	b, err := h.Store.CreateBooking(model.Booking{
+		UserID:    user.ID,
+		FirstName: in.FirstName,
+		LastName:  in.LastName,
+		Email:     in.Email,
+		Tickets:   in.Tickets,
+		BookedAt:  time.Now(),
+	})
+	if err == store.ErrNotEnoughTickets {
+		writeError(w, r, http.StatusBadRequest, "Invalid ticket count")
+		return
+	}
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not create booking")
+		return
+	}
+
+	if file, header, ferr := r.FormFile("attachment"); ferr == nil {
+		defer file.Close()
+		if _, err := h.Store.AttachFile(b.ID, header.Filename, file); err != nil {
+			h.Logger.Printf("attach file for booking %d: %v", b.ID, err)
+		}
+	}
+
+	if conf, err := h.Store.GetConference(); err == nil {
+		h.Hub.Publish(Event{Type: EventBookingCreated, Booking: &b, Remaining: conf.RemainingTickets, RowHTML: h.renderRowHTML(b), ownerID: b.UserID})
+	}
+
+	if wantsJSON(r) {
+		writeJSON(w, http.StatusCreated, b)
+		return
+	}
+	http.Redirect(w, r, "/", http.StatusSeeOther)
+}
+
+// CancelBooking cancels the booking named by the :id URL param. Non-admins
+// may only cancel their own bookings.
+func (h *Handler) CancelBooking(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	id, err := strconv.Atoi(ps.ByName("id"))
+	if err != nil {
+		writeError(w, r, http.StatusBadRequest, "Invalid booking id")
+		return
+	}
+
+	user, _ := userFromContext(r.Context())
+	bookings, err := h.Store.ListBookings()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load bookings")
+		return
+	}
+	ownerID, found := 0, false
+	for _, b := range bookings {
+		if b.ID == id {
+			ownerID, found = b.UserID, true
+			break
+		}
+	}
+	if !user.IsAdmin && (!found || ownerID != user.ID) {
+		writeError(w, r, http.StatusForbidden, "Not your booking")
+		return
+	}
+
+	err = h.Store.CancelBooking(id)
+	if err != nil && err != store.ErrBookingNotFound {
+		writeError(w, r, http.StatusInternalServerError, "Could not cancel booking")
+		return
+	}
+	if err == nil {
+		if conf, cErr := h.Store.GetConference(); cErr == nil {
+			h.Hub.Publish(Event{Type: EventBookingCancelled, BookingID: id, Remaining: conf.RemainingTickets, ownerID: ownerID})
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,92 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/julienschmidt/httprouter"
+)
+
+func idParam(id int) httprouter.Params {
+	return httprouter.Params{httprouter.Param{Key: "id", Value: strconv.Itoa(id)}}
+}
+
+func TestCreateBookingJSON(t *testing.T) {
+	h := newTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/bookings",
+		strings.NewReader(`{"first_name":"Ada","last_name":"Lovelace","email":"ada@example.com","tickets":2}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 1}))
+	rec := httptest.NewRecorder()
+	h.CreateBooking(rec, req, httprouter.Params{})
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body)
+	}
+
+	conf, err := h.Store.GetConference()
+	if err != nil {
+		t.Fatalf("GetConference: %v", err)
+	}
+	if conf.RemainingTickets != 8 {
+		t.Fatalf("expected 2 tickets to be deducted, got %d remaining", conf.RemainingTickets)
+	}
+}
+
+func TestCancelBookingOwnerCanCancelOwnBooking(t *testing.T) {
+	h := newTestHandler(t)
+	b, err := h.Store.CreateBooking(model.Booking{UserID: 1, FirstName: "Ada", Tickets: 1})
+	if err != nil {
+		t.Fatalf("seed booking: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/bookings/"+strconv.Itoa(b.ID), nil)
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 1}))
+	rec := httptest.NewRecorder()
+	h.CancelBooking(rec, req, idParam(b.ID))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body)
+	}
+}
+
+func TestCancelBookingRejectsOtherUsersBooking(t *testing.T) {
+	h := newTestHandler(t)
+	b, err := h.Store.CreateBooking(model.Booking{UserID: 1, FirstName: "Ada", Tickets: 1})
+	if err != nil {
+		t.Fatalf("seed booking: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/bookings/"+strconv.Itoa(b.ID), nil)
+	req.Header.Set("Accept", "application/json")
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 2}))
+	rec := httptest.NewRecorder()
+	h.CancelBooking(rec, req, idParam(b.ID))
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for cancelling someone else's booking, got %d", rec.Code)
+	}
+}
+
+func TestCancelBookingAdminCanCancelAnyBooking(t *testing.T) {
+	h := newTestHandler(t)
+	b, err := h.Store.CreateBooking(model.Booking{UserID: 1, FirstName: "Ada", Tickets: 1})
+	if err != nil {
+		t.Fatalf("seed booking: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodDelete, "/bookings/"+strconv.Itoa(b.ID), nil)
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 99, IsAdmin: true}))
+	rec := httptest.NewRecorder()
+	h.CancelBooking(rec, req, idParam(b.ID))
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected an admin to cancel any booking, got %d: %s", rec.Code, rec.Body)
+	}
+}
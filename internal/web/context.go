@@ -0,0 +1,22 @@
+package web
+
+import (
+	"context"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+)
+
+type contextKey int
+
+const userContextKey contextKey = 0
+
+// withUser returns a copy of ctx carrying u, retrievable with userFromContext.
+func withUser(ctx context.Context, u model.User) context.Context {
+	return context.WithValue(ctx, userContextKey, u)
+}
+
+// userFromContext returns the user injected by requireAuth/optionalAuth, if any.
+func userFromContext(ctx context.Context) (model.User, bool) {
+	u, ok := ctx.Value(userContextKey).(model.User)
+	return u, ok
+}
@@ -0,0 +1,155 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/julienschmidt/httprouter"
+)
+
+// heartbeatInterval is how often idle SSE connections get a comment
+// line, so intermediaries (proxies, browsers) don't time them out.
+const heartbeatInterval = 15 * time.Second
+
+// eventBuffer is how many unread events a slow client may lag behind
+// before its events start getting dropped.
+const eventBuffer = 8
+
+// Event is a booking change broadcast to subscribed clients. RowHTML
+// is the booking rendered through the bookings-row partial, so clients
+// can splice it straight into the table without reimplementing the
+// row markup in JS. ownerID is never serialized; the Hub uses it to
+// scope what each subscriber actually receives, the same way
+// visibleBookings scopes the HTML/JSON views.
+type Event struct {
+	Type      string         `json:"type"`
+	Booking   *model.Booking `json:"booking,omitempty"`
+	BookingID int            `json:"bookingId,omitempty"`
+	Remaining int            `json:"remaining"`
+	RowHTML   string         `json:"rowHtml,omitempty"`
+
+	ownerID int
+}
+
+const (
+	EventBookingCreated   = "booking_created"
+	EventBookingCancelled = "booking_cancelled"
+)
+
+// Hub fans booking events out to every subscribed client, scoped to
+// what each subscriber is allowed to see. Slow consumers have events
+// dropped rather than blocking the publisher.
+type Hub struct {
+	mu      sync.Mutex
+	clients map[chan Event]model.User
+}
+
+// NewHub returns an empty, ready-to-use Hub.
+func NewHub() *Hub {
+	return &Hub{clients: make(map[chan Event]model.User)}
+}
+
+// Subscribe registers a new client, subscribing as user, and returns
+// the channel it should read events from. Callers must Unsubscribe
+// when done.
+func (h *Hub) Subscribe(user model.User) chan Event {
+	ch := make(chan Event, eventBuffer)
+	h.mu.Lock()
+	h.clients[ch] = user
+	h.mu.Unlock()
+	return ch
+}
+
+// Unsubscribe removes and closes a client's channel.
+func (h *Hub) Unsubscribe(ch chan Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.clients[ch]; ok {
+		delete(h.clients, ch)
+		close(ch)
+	}
+}
+
+// Publish fans e out to every subscribed client, scoping it to each
+// subscriber (see scopeEvent) and dropping it for any client whose
+// buffer is full instead of blocking.
+func (h *Hub) Publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch, user := range h.clients {
+		scoped := scopeEvent(e, user)
+		select {
+		case ch <- scoped:
+		default:
+		}
+	}
+}
+
+// scopeEvent strips the booking's identifying details from e unless
+// user is an admin or the booking's owner, so a live tab never learns
+// another user's name/email/ticket count from the SSE feed, matching
+// the same all-bookings-for-admins-own-only-otherwise rule
+// visibleBookings applies to the HTML/JSON views.
+func scopeEvent(e Event, user model.User) Event {
+	if user.IsAdmin || user.ID == e.ownerID {
+		return e
+	}
+	return Event{Type: e.Type, Remaining: e.Remaining}
+}
+
+// clientCount reports how many clients are currently subscribed.
+func (h *Hub) clientCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.clients)
+}
+
+// Events streams booking/cancellation updates as Server-Sent Events so
+// the bookings table and remaining-ticket counter can update live in
+// every connected browser without a page refresh. It requires auth so
+// the Hub always knows which user to scope each event to.
+func (h *Handler) Events(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, r, http.StatusInternalServerError, "Streaming unsupported")
+		return
+	}
+
+	user, _ := userFromContext(r.Context())
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ch := h.Hub.Subscribe(user)
+	defer h.Hub.Unsubscribe(ch)
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			flusher.Flush()
+		case <-ticker.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			flusher.Flush()
+		}
+	}
+}
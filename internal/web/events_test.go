@@ -0,0 +1,185 @@
+package web
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/julienschmidt/httprouter"
+)
+
+func TestHubPublishDeliversToSubscribers(t *testing.T) {
+	h := NewHub()
+	ch := h.Subscribe(model.User{IsAdmin: true})
+	defer h.Unsubscribe(ch)
+
+	b := model.Booking{ID: 1, UserID: 9, FirstName: "Ada"}
+	h.Publish(Event{Type: EventBookingCreated, Booking: &b, Remaining: 4, ownerID: 9})
+
+	select {
+	case got := <-ch:
+		if got.Type != EventBookingCreated || got.Booking == nil || got.Booking.ID != 1 || got.Remaining != 4 {
+			t.Fatalf("unexpected event: %+v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestHubPublishScopesToOwnerOrAdmin(t *testing.T) {
+	h := NewHub()
+	owner := h.Subscribe(model.User{ID: 9})
+	other := h.Subscribe(model.User{ID: 42})
+	admin := h.Subscribe(model.User{ID: 7, IsAdmin: true})
+	defer h.Unsubscribe(owner)
+	defer h.Unsubscribe(other)
+	defer h.Unsubscribe(admin)
+
+	b := model.Booking{ID: 1, UserID: 9, FirstName: "Ada", Email: "ada@example.com"}
+	h.Publish(Event{Type: EventBookingCreated, Booking: &b, Remaining: 4, RowHTML: "<tr>secret</tr>", ownerID: 9})
+
+	ownerEvent := recvEvent(t, owner)
+	if ownerEvent.Booking == nil || ownerEvent.RowHTML == "" {
+		t.Fatalf("expected the owner to see the full event, got %+v", ownerEvent)
+	}
+
+	adminEvent := recvEvent(t, admin)
+	if adminEvent.Booking == nil || adminEvent.RowHTML == "" {
+		t.Fatalf("expected the admin to see the full event, got %+v", adminEvent)
+	}
+
+	otherEvent := recvEvent(t, other)
+	if otherEvent.Booking != nil || otherEvent.RowHTML != "" {
+		t.Fatalf("expected a non-owner, non-admin subscriber to get no booking details, got %+v", otherEvent)
+	}
+	if otherEvent.Type != EventBookingCreated || otherEvent.Remaining != 4 {
+		t.Fatalf("expected the non-owner to still see type/remaining, got %+v", otherEvent)
+	}
+}
+
+func recvEvent(t *testing.T, ch chan Event) Event {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}
+	}
+}
+
+func TestHubPublishDropsForSlowConsumer(t *testing.T) {
+	h := NewHub()
+	ch := h.Subscribe(model.User{IsAdmin: true})
+	defer h.Unsubscribe(ch)
+
+	for i := 0; i < eventBuffer+5; i++ {
+		h.Publish(Event{Type: EventBookingCreated, Remaining: i})
+	}
+
+	if len(ch) != eventBuffer {
+		t.Fatalf("expected the channel to fill to its buffer size %d, got %d", eventBuffer, len(ch))
+	}
+}
+
+func TestHubUnsubscribeRemovesClient(t *testing.T) {
+	h := NewHub()
+	ch := h.Subscribe(model.User{})
+
+	if h.clientCount() != 1 {
+		t.Fatalf("expected 1 client, got %d", h.clientCount())
+	}
+
+	h.Unsubscribe(ch)
+
+	if h.clientCount() != 0 {
+		t.Fatalf("expected 0 clients after unsubscribe, got %d", h.clientCount())
+	}
+	if _, ok := <-ch; ok {
+		t.Fatal("expected the client channel to be closed")
+	}
+}
+
+func TestEventsHandlerCleansUpOnDisconnect(t *testing.T) {
+	h := &Handler{Hub: NewHub()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		h.Events(rec, req, httprouter.Params{})
+		close(done)
+	}()
+
+	// Give the handler a moment to subscribe before disconnecting.
+	for i := 0; i < 100 && h.Hub.clientCount() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if h.Hub.clientCount() != 1 {
+		t.Fatalf("expected the handler to subscribe, got %d clients", h.Hub.clientCount())
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after the client disconnected")
+	}
+
+	if h.Hub.clientCount() != 0 {
+		t.Fatalf("expected the handler to unsubscribe on disconnect, got %d clients", h.Hub.clientCount())
+	}
+}
+
+func TestEventsHandlerStreamsPublishedEvents(t *testing.T) {
+	h := &Handler{Hub: NewHub()}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = withUser(ctx, model.User{ID: 7, IsAdmin: true})
+	req := httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	done := make(chan struct{})
+	go func() {
+		h.Events(rec, req, httprouter.Params{})
+		close(done)
+	}()
+
+	for i := 0; i < 100 && h.Hub.clientCount() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+
+	b := model.Booking{ID: 7, FirstName: "Grace"}
+	h.Hub.Publish(Event{Type: EventBookingCreated, Booking: &b, Remaining: 3})
+
+	// Give the handler time to receive and write the event before we
+	// stop it and read the recorded body.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler did not return after cancellation")
+	}
+
+	var line string
+	scanner := bufio.NewScanner(strings.NewReader(rec.Body.String()))
+	for scanner.Scan() {
+		if l := scanner.Text(); strings.HasPrefix(l, "data: ") {
+			line = l
+		}
+	}
+
+	if !strings.Contains(line, `"booking_created"`) || !strings.Contains(line, `"ID":7`) {
+		t.Fatalf("expected a booking_created event for booking 7, got %q", line)
+	}
+}
@@ -0,0 +1,68 @@
+// Package web contains the HTTP layer: route registration, request
+// handling, and HTML rendering. Handlers depend only on the Store and
+// UserStore interfaces, a session store, an event Hub, a template set,
+// and a logger, so they can be exercised with httptest instead of
+// package-level globals.
+package web
+
+import (
+	"log"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/store"
+	"github.com/gorilla/sessions"
+)
+
+// uploadDir names the "uploads" directory cmd/server hands to the
+// store. Nothing in this package reads it: attachments (tickets, IDs,
+// ...) are written under the directory the Store was built with.
+const uploadDir = "uploads"
+
+// sessionName is the cookie name used for the login session.
+const sessionName = "ticketing_session"
+
+// Handler carries the dependencies every route needs, replacing the
+// package-level globals (conf, bookings, nextID, mu) the monolithic
+// version relied on.
+type Handler struct {
+	Store    store.Store
+	Users    store.UserStore
+	Sessions *sessions.CookieStore
+	Logger   *log.Logger
+	Hub      *Hub
+
+	// AdminEmails is the set of lowercased addresses (e.g. from the
+	// ADMIN_EMAILS env var) that get IsAdmin on registration. Nil
+	// means no account is ever promoted automatically.
+	AdminEmails map[string]struct{}
+
+	// dev reparses templates from disk on every request instead of
+	// using cachedTemplates, so template edits show up without a
+	// restart.
+	dev             bool
+	cachedTemplates *Templates
+}
+
+// New builds a Handler. In production (dev false) templates are
+// parsed once from the embedded filesystem; with dev true they're
+// reparsed from disk on every request instead.
+func New(s store.Store, users store.UserStore, sessionStore *sessions.CookieStore, logger *log.Logger, dev bool) *Handler {
+	if logger == nil {
+		logger = log.Default()
+	}
+	h := &Handler{
+		Store:    s,
+		Users:    users,
+		Sessions: sessionStore,
+		Logger:   logger,
+		Hub:      NewHub(),
+		dev:      dev,
+	}
+	if !dev {
+		tpls, err := parseTemplates(embeddedTemplateFS, "templates")
+		if err != nil {
+			panic(err)
+		}
+		h.cachedTemplates = tpls
+	}
+	return h
+}
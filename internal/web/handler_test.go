@@ -0,0 +1,23 @@
+package web
+
+import (
+	"io"
+	"log"
+	"testing"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/brianmwenda/GO-Ticketing_system/internal/store"
+	"github.com/gorilla/sessions"
+)
+
+// newTestHandler returns a Handler wired the same way cmd/server/main.go
+// wires one, backed by fresh in-memory stores, for handler tests to
+// exercise through httptest.
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	seed := model.Conference{Name: "Test Conf", TotalTickets: 10, RemainingTickets: 10}
+	s := store.NewInMemoryStore(seed, t.TempDir())
+	users := store.NewInMemoryUserStore()
+	sessionStore := sessions.NewCookieStore([]byte("test-secret"))
+	return New(s, users, sessionStore, log.New(io.Discard, "", 0), false)
+}
@@ -0,0 +1,81 @@
+package web
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/gorilla/csrf"
+	"github.com/julienschmidt/httprouter"
+)
+
+// render executes tpl with data plus the current request's CSRF
+// field, so every template can embed {{.csrfField}} in its forms.
+func render(w http.ResponseWriter, r *http.Request, tpl *template.Template, data map[string]any) {
+	if data == nil {
+		data = map[string]any{}
+	}
+	data["csrfField"] = csrf.TemplateField(r)
+	tpl.Execute(w, data)
+}
+
+// Index renders the conference overview and, for the current user,
+// the bookings they're allowed to see: all of them for admins, only
+// their own otherwise.
+func (h *Handler) Index(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	conf, err := h.Store.GetConference()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load conference")
+		return
+	}
+	all, err := h.Store.ListBookings()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load bookings")
+		return
+	}
+
+	user, loggedIn := userFromContext(r.Context())
+	bookings := visibleBookings(all, user, loggedIn)
+
+	if wantsJSON(r) {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"conference": conf,
+			"bookings":   bookings,
+		})
+		return
+	}
+
+	tpls, err := h.templates()
+	if err != nil {
+		writeError(w, r, http.StatusInternalServerError, "Could not load templates")
+		return
+	}
+
+	data := map[string]any{
+		"Conf":     conf,
+		"Bookings": bookings,
+	}
+	if loggedIn {
+		data["User"] = user
+	}
+	render(w, r, tpls.Index, data)
+}
+
+// visibleBookings filters all down to the bookings user is allowed to
+// see: every booking for admins, only their own for signed-in
+// non-admins, and none at all for anonymous callers.
+func visibleBookings(all []model.Booking, user model.User, loggedIn bool) []model.Booking {
+	if !loggedIn {
+		return nil
+	}
+	if user.IsAdmin {
+		return all
+	}
+	var mine []model.Booking
+	for _, b := range all {
+		if b.UserID == user.ID {
+			mine = append(mine, b)
+		}
+	}
+	return mine
+}
@@ -0,0 +1,82 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianmwenda/GO-Ticketing_system/internal/model"
+	"github.com/julienschmidt/httprouter"
+)
+
+// seedBookings creates one booking each for two different users and
+// returns them, for tests of visibleBookings' scoping.
+func seedBookings(t *testing.T, h *Handler) (owner, other model.Booking) {
+	t.Helper()
+	owner, err := h.Store.CreateBooking(model.Booking{UserID: 1, FirstName: "Ada", Email: "ada@example.com", Tickets: 1})
+	if err != nil {
+		t.Fatalf("seed owner booking: %v", err)
+	}
+	other, err = h.Store.CreateBooking(model.Booking{UserID: 2, FirstName: "Grace", Email: "grace@example.com", Tickets: 1})
+	if err != nil {
+		t.Fatalf("seed other booking: %v", err)
+	}
+	return owner, other
+}
+
+func decodeIndexBookings(t *testing.T, rec *httptest.ResponseRecorder) []model.Booking {
+	t.Helper()
+	var body struct {
+		Bookings []model.Booking `json:"bookings"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return body.Bookings
+}
+
+func TestIndexAnonymousSeesNoBookings(t *testing.T) {
+	h := newTestHandler(t)
+	seedBookings(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/json")
+	rec := httptest.NewRecorder()
+	h.Index(rec, req, httprouter.Params{})
+
+	if got := decodeIndexBookings(t, rec); len(got) != 0 {
+		t.Fatalf("expected no bookings visible to an anonymous caller, got %+v", got)
+	}
+}
+
+func TestIndexNonAdminSeesOnlyOwnBookings(t *testing.T) {
+	h := newTestHandler(t)
+	owner, _ := seedBookings(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/json")
+	req = req.WithContext(withUser(req.Context(), model.User{ID: owner.UserID}))
+	rec := httptest.NewRecorder()
+	h.Index(rec, req, httprouter.Params{})
+
+	got := decodeIndexBookings(t, rec)
+	if len(got) != 1 || got[0].ID != owner.ID {
+		t.Fatalf("expected only the caller's own booking, got %+v", got)
+	}
+}
+
+func TestIndexAdminSeesAllBookings(t *testing.T) {
+	h := newTestHandler(t)
+	seedBookings(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept", "application/json")
+	req = req.WithContext(withUser(req.Context(), model.User{ID: 99, IsAdmin: true}))
+	rec := httptest.NewRecorder()
+	h.Index(rec, req, httprouter.Params{})
+
+	if got := decodeIndexBookings(t, rec); len(got) != 2 {
+		t.Fatalf("expected the admin to see every booking, got %+v", got)
+	}
+}
@@ -0,0 +1,53 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+)
+
+// requireAuth rejects requests without a valid session and otherwise
+// injects the logged-in user into the request context.
+func (h *Handler) requireAuth(next httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		session, _ := h.Sessions.Get(r, sessionName)
+		id, ok := session.Values["userID"].(int)
+		if !ok {
+			h.unauthorized(w, r)
+			return
+		}
+
+		u, err := h.Users.GetUserByID(id)
+		if err != nil {
+			h.unauthorized(w, r)
+			return
+		}
+
+		next(w, r.WithContext(withUser(r.Context(), u)), ps)
+	}
+}
+
+// unauthorized reports a missing or invalid session: a redirect to the
+// login page for the browser UI, or a 401 problem+json body for API
+// and JSON-accepting clients.
+func (h *Handler) unauthorized(w http.ResponseWriter, r *http.Request) {
+	if wantsJSON(r) {
+		writeProblem(w, http.StatusUnauthorized, "Authentication required")
+		return
+	}
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+// optionalAuth injects the logged-in user into the context if present,
+// but does not require one (used for the index page).
+func (h *Handler) optionalAuth(next httprouter.Handle) httprouter.Handle {
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		session, _ := h.Sessions.Get(r, sessionName)
+		if id, ok := session.Values["userID"].(int); ok {
+			if u, err := h.Users.GetUserByID(id); err == nil {
+				r = r.WithContext(withUser(r.Context(), u))
+			}
+		}
+		next(w, r, ps)
+	}
+}
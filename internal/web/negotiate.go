@@ -0,0 +1,32 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// wantsJSON reports whether a request should be served a JSON response
+// rather than an HTML page: either it asked explicitly via Accept, or
+// it's hitting the versioned API, which is JSON-only.
+func wantsJSON(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "application/json") || strings.HasPrefix(r.URL.Path, "/api/")
+}
+
+// writeJSON encodes v as the response body with the given status code.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError reports status/detail to the client, as a problem+json
+// body for API and JSON-accepting clients or a plain HTML error page
+// otherwise, so every handler gets content negotiation for free.
+func writeError(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	if wantsJSON(r) {
+		writeProblem(w, status, detail)
+		return
+	}
+	http.Error(w, detail, status)
+}
@@ -0,0 +1,24 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem is an RFC 7807 application/problem+json error body.
+type Problem struct {
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// writeProblem writes status and detail as an application/problem+json body.
+func writeProblem(w http.ResponseWriter, status int, detail string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	})
+}
@@ -0,0 +1,30 @@
+package web
+
+import "github.com/julienschmidt/httprouter"
+
+// Router builds the full route table for the ticketing app.
+func (h *Handler) Router() *httprouter.Router {
+	router := httprouter.New()
+
+	router.GET("/", h.optionalAuth(h.Index))
+	router.GET("/login", h.Login)
+	router.POST("/login", h.Login)
+	router.GET("/register", h.Register)
+	router.POST("/register", h.Register)
+	router.POST("/logout", h.Logout)
+
+	router.POST("/bookings", h.requireAuth(h.CreateBooking))
+	router.DELETE("/bookings/:id", h.requireAuth(h.CancelBooking))
+	router.GET("/events", h.requireAuth(h.Events))
+
+	// Versioned JSON API. CreateBooking and CancelBooking are the same
+	// handlers as the HTML routes above: they negotiate their request
+	// and response bodies off Content-Type/Accept, so both surfaces
+	// share identical business logic.
+	router.GET("/api/v1/conference", h.optionalAuth(h.APIConference))
+	router.GET("/api/v1/bookings", h.requireAuth(h.APIListBookings))
+	router.POST("/api/v1/bookings", h.requireAuth(h.CreateBooking))
+	router.DELETE("/api/v1/bookings/:id", h.requireAuth(h.CancelBooking))
+
+	return router
+}
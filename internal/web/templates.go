@@ -0,0 +1,53 @@
+package web
+
+import (
+	"embed"
+	"html/template"
+	"io/fs"
+	"os"
+)
+
+//go:embed templates/*.html templates/partials/*.html
+var embeddedTemplateFS embed.FS
+
+// devTemplatesDir is where templates live on disk relative to the
+// process's working directory, used only in --dev mode so edits show
+// up without restarting the server.
+const devTemplatesDir = "internal/web/templates"
+
+// Templates holds every page template the web layer renders, plus the
+// bookings-row partial the SSE handler reuses to render a single row.
+// Every template here can invoke the header/footer/bookings-row
+// partials parsed alongside it.
+type Templates struct {
+	Index    *template.Template
+	Login    *template.Template
+	Register *template.Template
+	Row      *template.Template
+}
+
+// parseTemplates parses the page templates and partials rooted at dir
+// within fsys into one shared template set.
+func parseTemplates(fsys fs.FS, dir string) (*Templates, error) {
+	tpl, err := template.ParseFS(fsys, dir+"/*.html", dir+"/partials/*.html")
+	if err != nil {
+		return nil, err
+	}
+	return &Templates{
+		Index:    tpl.Lookup("index.html"),
+		Login:    tpl.Lookup("login.html"),
+		Register: tpl.Lookup("register.html"),
+		Row:      tpl.Lookup("bookings-row.html"),
+	}, nil
+}
+
+// templates returns the current template set: in --dev mode it's
+// reparsed from disk on every call so edits show up immediately; in
+// production it's the set parsed once at startup from the embedded
+// filesystem.
+func (h *Handler) templates() (*Templates, error) {
+	if h.dev {
+		return parseTemplates(os.DirFS("."), devTemplatesDir)
+	}
+	return h.cachedTemplates, nil
+}